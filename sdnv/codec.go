@@ -23,7 +23,7 @@
 package sdnv
 
 import (
-	"fmt"
+	"errors"
 	"io"
 	"math/big"
 	"math/bits"
@@ -32,9 +32,10 @@ import (
 // MaxByteSize is the largest number of bytes a uint64 might be encoded into
 const MaxByteSize = 10
 
-// ErrOverflow64 is the string sentinel value returned when overflowing
-// a 64-bit integer
-const ErrOverflow64 = "sdnv: byte sequence overflows a 64-bit integer"
+// ErrOverflow64 is the sentinel error returned when overflowing a 64-bit
+// integer. It is a typed error rather than a formatted string so callers
+// can detect it with errors.Is.
+var ErrOverflow64 = errors.New("sdnv: byte sequence overflows a 64-bit integer")
 
 // Encode puts the given uint64 into the buffer, and return the number of
 // bytes used in the buffer.
@@ -107,10 +108,10 @@ func Write(w io.Writer, x uint64) (n int, err error) {
 // Decode retrieves a uint64 value from the buffer, returning the uint64 and
 // the number of bytes consumed from the buffer.
 // Get panics if it runs out of bytes in the buffer before encountering
-// the delimiter byte.
+// the delimiter byte. It also does not detect overflow past 64 bits; see
+// DecodeSafe for an overflow-checked, non-panicking alternative.
 // Design can be found at: https://tools.ietf.org/html/rfc5050#section-4.1
 func Decode(buf []byte) (x uint64, n int) {
-	// TODO: Overflow like binary.Uvarint?!?
 	for {
 		x |= uint64(buf[n] & 0x7f)
 		if buf[n] < 0x80 {
@@ -121,6 +122,48 @@ func Decode(buf []byte) (x uint64, n int) {
 	}
 }
 
+// DecodeSafe retrieves a uint64 value from the buffer, mirroring the
+// semantics of encoding/binary.Uvarint: it returns the value and the number
+// of bytes consumed, along with an error if the buffer does not hold a
+// complete, well-formed SDNV.
+// If buf is empty, DecodeSafe returns (0, 0, io.EOF).
+// If buf ends before the delimiter byte is reached, DecodeSafe returns
+// (0, 0, io.ErrUnexpectedEOF).
+// If the encoded value overflows a 64-bit integer -- including the case
+// where a 10-byte sequence's first byte is not 0x81, per the Note in RFC
+// 5050 4.1 -- DecodeSafe returns (0, -n, err) where n is the number of
+// bytes read and err contains ErrOverflow64.
+func DecodeSafe(buf []byte) (x uint64, n int, err error) {
+	if len(buf) == 0 {
+		return 0, 0, io.EOF
+	}
+	var b0 byte // For overflow check
+	for n < len(buf) {
+		b := buf[n]
+		if n == MaxByteSize-1 {
+			// We're on the last possible byte, but it says to pull more
+			if b >= 0x80 {
+				return 0, -(n + 1), ErrOverflow64
+			}
+			// For a 10-byte the only acceptable value for the first
+			// byte is 0x81. See the Note in RFC 5050 4.1
+			if b0 != 0x81 {
+				return 0, -(n + 1), ErrOverflow64
+			}
+		}
+		if n == 0 {
+			b0 = b
+		}
+		x |= uint64(b & 0x7f)
+		if b < 0x80 {
+			return x, n + 1, nil
+		}
+		x <<= 7
+		n++
+	}
+	return 0, 0, io.ErrUnexpectedEOF
+}
+
 func decodeBig(buf []byte) (x *big.Int, n int) {
 	x = big.NewInt(0)
 	for {
@@ -139,8 +182,8 @@ func decodeBig(buf []byte) (x *big.Int, n int) {
 // any have been read but an io.EOF is encountered, io.ErrUnexpectedEOF is
 // returned instead.
 // If the bytes indicate a number greater than can be held by a 64-bit
-// integer, the number of bytes read will be returned along with an error
-// containing the string value of ErrOverflow64.
+// integer, the number of bytes read will be returned along with
+// ErrOverflow64.
 func ReadBytes(br io.ByteReader, data *uint64) (n int, err error) {
 	var b0 byte // For overflow check
 	for {
@@ -154,12 +197,12 @@ func ReadBytes(br io.ByteReader, data *uint64) (n int, err error) {
 		if n == MaxByteSize-1 {
 			// We're on the last possible byte, but it says to pull more
 			if b >= 0x80 {
-				return MaxByteSize, fmt.Errorf(ErrOverflow64)
+				return MaxByteSize, ErrOverflow64
 			}
 			// For a 10-byte the only acceptable value for the first
 			// byte is 0x81. See the Note in RFC 5050 4.1
 			if b0 != 0x81 {
-				return MaxByteSize, fmt.Errorf(ErrOverflow64)
+				return MaxByteSize, ErrOverflow64
 			}
 		}
 		if n == 0 {
@@ -179,8 +222,8 @@ func ReadBytes(br io.ByteReader, data *uint64) (n int, err error) {
 // any have been read but an io.EOF is encountered, io.ErrUnexpectedEOF is
 // returned instead.
 // If the bytes indicate a number greater than can be held by a 64-bit
-// integer, the number of bytes read will be returned along with an error
-// containing the string value of ErrOverflow64.
+// integer, the number of bytes read will be returned along with
+// ErrOverflow64.
 func Read(r io.Reader, data *uint64) (n int, err error) {
 	var b0 byte // For overflow check
 	buf := make([]byte, 1)
@@ -196,12 +239,12 @@ func Read(r io.Reader, data *uint64) (n int, err error) {
 		if n == MaxByteSize {
 			// We're on the last possible byte, but it says to pull more
 			if buf[0] >= 0x80 {
-				return MaxByteSize, fmt.Errorf(ErrOverflow64)
+				return MaxByteSize, ErrOverflow64
 			}
 			// For a 10-byte the only acceptable value for the first
 			// byte is 0x81. See the Note in RFC 5050 4.1
 			if b0 != 0x81 {
-				return MaxByteSize, fmt.Errorf(ErrOverflow64)
+				return MaxByteSize, ErrOverflow64
 			}
 		}
 		if n == 1 {
@@ -222,7 +222,7 @@ func TestDecodeErrors(t *testing.T) {
 				0xff, 0xff, 0xff, 0xff,
 			},
 			10,
-			ErrOverflow64,
+			ErrOverflow64.Error(),
 		},
 		{
 			"one too many most significant bits",
@@ -233,7 +233,7 @@ func TestDecodeErrors(t *testing.T) {
 				0x7f,
 			},
 			10,
-			ErrOverflow64,
+			ErrOverflow64.Error(),
 		},
 	}
 
@@ -270,4 +270,80 @@ func TestDecodeErrors(t *testing.T) {
 	}
 }
 
+func TestDecodeSafe(t *testing.T) {
+	buf := make([]byte, MaxByteSize)
+	for _, test := range tests {
+		size := Encode(buf, test.num)
+
+		r, n, err := DecodeSafe(buf[:size])
+		if err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+		if size != n {
+			t.Errorf("expected %d: %d\n", size, n)
+		}
+		if test.num != r {
+			t.Errorf("expected %d: %d\n", test.num, r)
+		}
+	}
+}
+
+func TestDecodeSafeErrors(t *testing.T) {
+	var testCases = []struct {
+		name string
+		data []byte
+		size int
+		err  string
+	}{
+		{
+			"zero length",
+			[]byte{},
+			0,
+			io.EOF.Error(),
+		},
+		{
+			"non-zero underflow",
+			[]byte{0xff, 0xff},
+			0,
+			io.ErrUnexpectedEOF.Error(),
+		},
+		{
+			"greater than 64 bit integer",
+			[]byte{
+				0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff,
+			},
+			-10,
+			ErrOverflow64.Error(),
+		},
+		{
+			"one too many most significant bits",
+			[]byte{
+				0x83,
+				0xff, 0xff, 0xff, 0xff,
+				0xff, 0xff, 0xff, 0xff,
+				0x7f,
+			},
+			-10,
+			ErrOverflow64.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, n, err := DecodeSafe(tc.data)
+			if tc.size != n {
+				t.Errorf("expected %d: %d\n", tc.size, n)
+			}
+			if val != 0 {
+				t.Errorf("expected 0: %d\n", val)
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected [%s]: %v\n", tc.err, err)
+			}
+		})
+	}
+}
+
 // TODO: TestPanics
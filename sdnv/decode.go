@@ -20,9 +20,13 @@
 
 package sdnv
 
+import "io"
+
 // Get will decode a uint64 Self-Delimiting Numeric Value from the buffer
 // and return the uint64 and the number of bytes consumed from the buffer.
-// Put panics if there is not enough space in the buffer.
+// Get panics if it runs out of bytes in the buffer before encountering
+// the delimiter byte. It also does not detect overflow past 64 bits; see GetSafe for an overflow-checked,
+// non-panicking alternative.
 // Design can be found at: https://tools.ietf.org/html/rfc5050#section-4.1
 func Get(buf []byte) (x uint64, n int) {
 	for {
@@ -34,3 +38,34 @@ func Get(buf []byte) (x uint64, n int) {
 		n++
 	}
 }
+
+// GetSafe is the non-panicking sibling of Get: it returns the same
+// (uint64, int, error) shape as DecodeSafe, for callers that want Get's
+// buffer semantics without risking a panic on malformed input.
+func GetSafe(buf []byte) (x uint64, n int, err error) {
+	if len(buf) == 0 {
+		return 0, 0, io.EOF
+	}
+	var b0 byte // For overflow check
+	for n < len(buf) {
+		b := buf[n]
+		if n == MaxByteSize-1 {
+			if b >= 0x80 {
+				return 0, -(n + 1), ErrOverflow64
+			}
+			if b0 != 0x81 {
+				return 0, -(n + 1), ErrOverflow64
+			}
+		}
+		if n == 0 {
+			b0 = b
+		}
+		x |= uint64(b & 0x7f)
+		if b < 0x80 {
+			return x, n + 1, nil
+		}
+		x <<= 7
+		n++
+	}
+	return 0, 0, io.ErrUnexpectedEOF
+}
@@ -25,7 +25,7 @@ import "testing"
 func TestGet(t *testing.T) {
 	buf := make([]byte, 10)
 	for _, test := range tests {
-		size := Put(buf, test.num)
+		size := Encode(buf, test.num)
 		r, n := Get(buf[:size])
 
 		if size != n {
@@ -37,3 +37,21 @@ func TestGet(t *testing.T) {
 	}
 
 }
+
+func TestGetSafe(t *testing.T) {
+	buf := make([]byte, MaxByteSize)
+	for _, test := range tests {
+		size := Encode(buf, test.num)
+		r, n, err := GetSafe(buf[:size])
+
+		if err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+		if size != n {
+			t.Errorf("expected %d: %d\n", size, n)
+		}
+		if test.num != r {
+			t.Errorf("expected %d: %d\n", test.num, r)
+		}
+	}
+}
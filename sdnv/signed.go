@@ -0,0 +1,110 @@
+// Copyright © 2017 Nelz
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sdnv
+
+import (
+	"io"
+	"math/big"
+)
+
+// An SDNV is an unsigned format, but zigzag encoding lets it carry signed
+// values too: small-magnitude negatives stay cheap (-1 encodes in 1 byte)
+// instead of ballooning to the 10-byte max a two's-complement cast would
+// produce. The wire bytes are still a plain, valid SDNV, so a reader that
+// doesn't know about the zigzag layer can still frame them; it just won't
+// recover the original sign without applying the same transform.
+
+// zigzagEncode maps a signed int64 onto the unsigned range so that it can
+// be fed through the normal SDNV encoding path.
+func zigzagEncode(x int64) uint64 {
+	return uint64((x << 1) ^ (x >> 63))
+}
+
+// zigzagDecode is the inverse of zigzagEncode.
+func zigzagDecode(u uint64) int64 {
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+// zigzagEncodeBig is the arbitrary-precision equivalent of zigzagEncode.
+func zigzagEncodeBig(x *big.Int) *big.Int {
+	if x.Sign() < 0 {
+		u := new(big.Int).Neg(x)
+		u.Lsh(u, 1)
+		return u.Sub(u, big.NewInt(1))
+	}
+	return new(big.Int).Lsh(x, 1)
+}
+
+// zigzagDecodeBig is the inverse of zigzagEncodeBig.
+func zigzagDecodeBig(u *big.Int) *big.Int {
+	x := new(big.Int).Rsh(u, 1)
+	if u.Bit(0) == 1 {
+		x.Add(x, big.NewInt(1))
+		x.Neg(x)
+	}
+	return x
+}
+
+// EncodeInt64 puts the given int64 into the buffer as a zigzag-encoded
+// SDNV, and returns the number of bytes used in the buffer.
+// EncodeInt64 panics if there is not enough space in the buffer.
+func EncodeInt64(buf []byte, x int64) (n int) {
+	return Encode(buf, zigzagEncode(x))
+}
+
+// DecodeInt64 retrieves a zigzag-encoded int64 value from the buffer,
+// returning the int64 and the number of bytes consumed from the buffer.
+// DecodeInt64 panics if it runs out of bytes in the buffer before
+// encountering the delimiter byte.
+func DecodeInt64(buf []byte) (x int64, n int) {
+	u, n := Decode(buf)
+	return zigzagDecode(u), n
+}
+
+// DecodeInt64Safe is the non-panicking sibling of DecodeInt64, mirroring
+// the (value, count, error) semantics of DecodeSafe.
+func DecodeInt64Safe(buf []byte) (x int64, n int, err error) {
+	u, n, err := DecodeSafe(buf)
+	return zigzagDecode(u), n, err
+}
+
+func encodeBigInt(buf []byte, in *big.Int) (n int) {
+	return encodeBig(buf, zigzagEncodeBig(in))
+}
+
+func decodeBigInt(buf []byte) (x *big.Int, n int) {
+	u, n := decodeBig(buf)
+	return zigzagDecodeBig(u), n
+}
+
+// WriteInt zigzag-encodes x and writes it to w as an SDNV.
+func WriteInt(w io.Writer, x int64) (n int, err error) {
+	return Write(w, zigzagEncode(x))
+}
+
+// ReadInt will read individual bytes on-demand as needed to fill data with
+// a zigzag-decoded int64. Its error semantics are identical to Read.
+func ReadInt(r io.Reader, data *int64) (n int, err error) {
+	var u uint64
+	n, err = Read(r, &u)
+	*data = zigzagDecode(u)
+	return n, err
+}
@@ -0,0 +1,115 @@
+// Copyright © 2017 Nelz
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sdnv
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+var signedTests = []struct {
+	num  int64
+	size int
+}{
+	{0, 1},
+	{-1, 1},
+	{1, 1},
+	{-64, 1},
+	{63, 1},
+	{0x1234, 2},
+	{-0x1234, 2},
+	{9223372036854775807, MaxByteSize},
+	{-9223372036854775808, MaxByteSize},
+}
+
+func TestEncodeDecodeInt64(t *testing.T) {
+	buf := make([]byte, MaxByteSize)
+	for _, test := range signedTests {
+		size := EncodeInt64(buf, test.num)
+		if size != test.size {
+			t.Errorf("expected %d: %d\n", test.size, size)
+		}
+
+		r, n := DecodeInt64(buf[:size])
+		if size != n {
+			t.Errorf("expected %d: %d\n", size, n)
+		}
+		if test.num != r {
+			t.Errorf("expected %d: %d\n", test.num, r)
+		}
+
+		sr, sn, err := DecodeInt64Safe(buf[:size])
+		if err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+		if size != sn {
+			t.Errorf("expected %d: %d\n", size, sn)
+		}
+		if test.num != sr {
+			t.Errorf("expected %d: %d\n", test.num, sr)
+		}
+	}
+}
+
+func TestWriteReadInt(t *testing.T) {
+	for _, test := range signedTests {
+		bb := bytes.NewBufferString("")
+		wSize, err := WriteInt(bb, test.num)
+		if err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+		if wSize != test.size {
+			t.Errorf("expected %d: %d\n", test.size, wSize)
+		}
+
+		var r int64
+		rSize, err := ReadInt(bb, &r)
+		if err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+		if rSize != test.size {
+			t.Errorf("expected %d: %d\n", test.size, rSize)
+		}
+		if test.num != r {
+			t.Errorf("expected %d: %d\n", test.num, r)
+		}
+	}
+}
+
+func TestBigIntSigned(t *testing.T) {
+	buf := make([]byte, MaxByteSize)
+	for _, test := range signedTests {
+		x := big.NewInt(test.num)
+		size := encodeBigInt(buf, x)
+		if size != test.size {
+			t.Errorf("expected %d: %d\n", test.size, size)
+		}
+
+		r, n := decodeBigInt(buf[:size])
+		if size != n {
+			t.Errorf("expected %d: %d\n", size, n)
+		}
+		if r.Cmp(x) != 0 {
+			t.Errorf("expected %s: %s\n", x, r)
+		}
+	}
+}
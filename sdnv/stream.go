@@ -0,0 +1,113 @@
+// Copyright © 2017 Nelz
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sdnv
+
+import (
+	"bufio"
+	"io"
+)
+
+// Encoder buffers writes to an underlying io.Writer so that a batch of
+// SDNVs can be encoded without a syscall, or a 10-byte scratch allocation,
+// per value.
+type Encoder struct {
+	w *bufio.Writer
+}
+
+// NewEncoder returns an Encoder that buffers its writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// EncodeUint64 writes x to the Encoder's buffer as an SDNV, returning the
+// number of bytes written.
+func (e *Encoder) EncodeUint64(x uint64) (n int, err error) {
+	return WriteBytes(e.w, x)
+}
+
+// EncodeSlice writes each value in xs, in order, returning the total
+// number of bytes written. It stops and returns at the first error.
+func (e *Encoder) EncodeSlice(xs []uint64) (n int, err error) {
+	for _, x := range xs {
+		l, err := e.EncodeUint64(x)
+		n += l
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Decoder buffers reads from an underlying io.Reader so that a batch of
+// SDNVs can be decoded without issuing a syscall per byte.
+type Decoder struct {
+	r *bufio.Reader
+}
+
+// NewDecoder returns a Decoder that buffers its reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// DecodeUint64 reads a single SDNV from the Decoder's buffer, returning the
+// decoded value and the number of bytes consumed. Its error semantics are
+// identical to ReadBytes.
+func (d *Decoder) DecodeUint64() (x uint64, n int, err error) {
+	n, err = ReadBytes(d.r, &x)
+	return x, n, err
+}
+
+// DecodeSlice reads n SDNVs into dst, returning the number successfully
+// decoded. It stops and returns at the first error, which may leave dst
+// partially filled.
+func (d *Decoder) DecodeSlice(dst []uint64, n int) (count int, err error) {
+	for count < n {
+		x, _, err := d.DecodeUint64()
+		if err != nil {
+			return count, err
+		}
+		dst[count] = x
+		count++
+	}
+	return count, nil
+}
+
+// Peek returns the next n bytes without advancing the Decoder, per
+// bufio.Reader.Peek.
+func (d *Decoder) Peek(n int) ([]byte, error) {
+	return d.r.Peek(n)
+}
+
+// Discard skips the next n bytes, per bufio.Reader.Discard.
+func (d *Decoder) Discard(n int) (discarded int, err error) {
+	return d.r.Discard(n)
+}
+
+// Reset discards any buffered data and switches the Decoder to read from r,
+// so a single Decoder can be reused across bundles.
+func (d *Decoder) Reset(r io.Reader) {
+	d.r.Reset(r)
+}
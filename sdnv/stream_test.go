@@ -0,0 +1,133 @@
+// Copyright © 2017 Nelz
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sdnv
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestEncoderDecoderUint64(t *testing.T) {
+	bb := bytes.NewBufferString("")
+	enc := NewEncoder(bb)
+	for _, test := range tests {
+		if _, err := enc.EncodeUint64(test.num); err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+	}
+	if err := enc.Flush(); err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+
+	dec := NewDecoder(bb)
+	for _, test := range tests {
+		x, n, err := dec.DecodeUint64()
+		if err != nil {
+			t.Errorf("unexpected: %v\n", err)
+		}
+		if n == 0 {
+			t.Errorf("expected non-zero bytes consumed\n")
+		}
+		if test.num != x {
+			t.Errorf("expected %d: %d\n", test.num, x)
+		}
+	}
+}
+
+func TestEncoderEncodeSlice(t *testing.T) {
+	xs := make([]uint64, len(tests))
+	for i, test := range tests {
+		xs[i] = test.num
+	}
+
+	bb := bytes.NewBufferString("")
+	enc := NewEncoder(bb)
+	if _, err := enc.EncodeSlice(xs); err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+	if err := enc.Flush(); err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+
+	dec := NewDecoder(bb)
+	dst := make([]uint64, len(xs))
+	count, err := dec.DecodeSlice(dst, len(xs))
+	if err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+	if count != len(xs) {
+		t.Errorf("expected %d: %d\n", len(xs), count)
+	}
+	for i, x := range xs {
+		if dst[i] != x {
+			t.Errorf("expected %d: %d\n", x, dst[i])
+		}
+	}
+}
+
+func TestDecoderPeekDiscardReset(t *testing.T) {
+	buf := make([]byte, MaxByteSize)
+	size := Encode(buf, tests[0].num)
+
+	dec := NewDecoder(bytes.NewReader(buf[:size]))
+	peeked, err := dec.Peek(size)
+	if err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+	if !bytes.Equal(peeked, buf[:size]) {
+		t.Errorf("expected %b: %b\n", buf[:size], peeked)
+	}
+
+	discarded, err := dec.Discard(size)
+	if err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+	if discarded != size {
+		t.Errorf("expected %d: %d\n", size, discarded)
+	}
+
+	size2 := Encode(buf, tests[1].num)
+	dec.Reset(bytes.NewReader(buf[:size2]))
+	x, n, err := dec.DecodeUint64()
+	if err != nil {
+		t.Errorf("unexpected: %v\n", err)
+	}
+	if n != size2 {
+		t.Errorf("expected %d: %d\n", size2, n)
+	}
+	if x != tests[1].num {
+		t.Errorf("expected %d: %d\n", tests[1].num, x)
+	}
+}
+
+func TestDecoderOverflowIsErrOverflow64(t *testing.T) {
+	data := []byte{
+		0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff,
+		0xff, 0xff, 0xff, 0xff,
+	}
+	dec := NewDecoder(bytes.NewReader(data))
+	_, _, err := dec.DecodeUint64()
+	if !errors.Is(err, ErrOverflow64) {
+		t.Errorf("expected errors.Is ErrOverflow64: %v\n", err)
+	}
+}